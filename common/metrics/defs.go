@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+// Scope indices. Each instrumented subsystem owns one entry here.
+const (
+	// NDCHistoryResenderScope covers the cross-DC history resend path (common/xdc).
+	NDCHistoryResenderScope = iota
+)
+
+// Metric indices scoped to NDCHistoryResenderScope.
+const (
+	// NDCHistoryResenderTotalLatency times a full SendSingleWorkflowHistory call.
+	NDCHistoryResenderTotalLatency = iota
+	// NDCHistoryResenderGetHistoryLatency times a single GetWorkflowExecutionRawHistoryV2 call,
+	// including retries.
+	NDCHistoryResenderGetHistoryLatency
+	// NDCHistoryResenderReplicateLatency times a single history replication call, including
+	// retries.
+	NDCHistoryResenderReplicateLatency
+	// NDCHistoryResenderBatchFetchedCount counts history batches fetched from the remote cluster.
+	NDCHistoryResenderBatchFetchedCount
+	// NDCHistoryResenderBatchReplicatedCount counts history batches successfully replicated.
+	NDCHistoryResenderBatchReplicatedCount
+	// NDCHistoryResenderBytesCount sums the serialized size of fetched history batches.
+	NDCHistoryResenderBytesCount
+	// NDCHistoryResenderErrorCount counts failed fetch/replicate calls, tagged by
+	// ServiceErrorTypeTag.
+	NDCHistoryResenderErrorCount
+)
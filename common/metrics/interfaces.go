@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import "time"
+
+type (
+	// Client emits metrics identified by a scope index and, within that scope, a counter/timer
+	// index. Scope indices and metric indices are defined per-subsystem in this package (see
+	// NDCHistoryResenderScope and its metric indices for an example).
+	Client interface {
+		IncCounter(scopeIdx int, counterIdx int)
+		AddCounter(scopeIdx int, counterIdx int, delta int64)
+		StartTimer(scopeIdx int, timerIdx int) Stopwatch
+		Scope(scopeIdx int, tags ...Tag) Scope
+	}
+
+	// Scope is a Client pre-bound to one scope, optionally further tagged. Tagged calls return a
+	// new Scope so the caller's own scope reference is never mutated.
+	Scope interface {
+		IncCounter(counterIdx int)
+		AddCounter(counterIdx int, delta int64)
+		StartTimer(timerIdx int) Stopwatch
+		Tagged(tags ...Tag) Scope
+	}
+
+	// Stopwatch records an elapsed duration against a timer metric once stopped.
+	Stopwatch interface {
+		Stop() time.Duration
+	}
+)
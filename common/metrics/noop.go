@@ -0,0 +1,49 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import "time"
+
+type (
+	noopStopwatch struct{}
+	noopScope     struct{}
+	noopClient    struct{}
+)
+
+func (noopStopwatch) Stop() time.Duration { return 0 }
+
+func (noopScope) IncCounter(int)           {}
+func (noopScope) AddCounter(int, int64)    {}
+func (noopScope) StartTimer(int) Stopwatch { return noopStopwatch{} }
+func (noopScope) Tagged(...Tag) Scope      { return noopScope{} }
+
+func (noopClient) IncCounter(int, int)           {}
+func (noopClient) AddCounter(int, int, int64)    {}
+func (noopClient) StartTimer(int, int) Stopwatch { return noopStopwatch{} }
+func (noopClient) Scope(int, ...Tag) Scope       { return noopScope{} }
+
+// NoopClient discards every metric it is given. It is useful for tests and other contexts where
+// metrics reporting isn't wired up.
+var NoopClient Client = noopClient{}
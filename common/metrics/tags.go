@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+// Tag is a metric dimension: a key/value pair attached to a Scope via Client.Scope or
+// Scope.Tagged.
+type Tag interface {
+	Key() string
+	Value() string
+}
+
+type tagImpl struct {
+	key   string
+	value string
+}
+
+func (t tagImpl) Key() string   { return t.key }
+func (t tagImpl) Value() string { return t.value }
+
+const (
+	namespaceIDTagName      = "namespace_id"
+	targetClusterTagName    = "target_cluster"
+	serviceErrorTypeTagName = "error_type"
+)
+
+// NamespaceIDTag tags a metric with the namespace it was emitted for.
+func NamespaceIDTag(namespaceID string) Tag {
+	return tagImpl{key: namespaceIDTagName, value: namespaceID}
+}
+
+// TargetClusterTag tags a metric with the remote cluster a cross-DC call targeted.
+func TargetClusterTag(targetCluster string) Tag {
+	return tagImpl{key: targetClusterTagName, value: targetCluster}
+}
+
+// ServiceErrorTypeTag tags a metric with the classification (e.g. a gRPC status code string) of
+// the error a call failed with.
+func ServiceErrorTypeTag(errorType string) Tag {
+	return tagImpl{key: serviceErrorTypeTagName, value: errorType}
+}
@@ -25,8 +25,10 @@
 package interceptor
 
 import (
+	"container/list"
 	"context"
 	"sync"
+	"time"
 
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/version/check"
@@ -38,20 +40,110 @@ type sdkNameVersion struct {
 	version string
 }
 
+// sdkInfoEntry is the value held by each element of SDKVersionInterceptor.order; lastAccess
+// drives TTL eviction and the element's list position drives LRU eviction.
+type sdkInfoEntry struct {
+	key        sdkNameVersion
+	lastAccess time.Time
+}
+
+// EvictionPolicy controls which recorded SDK name/version tuples SDKVersionInterceptor drops
+// to stay within bounds.
+type EvictionPolicy int
+
+const (
+	// EvictionPolicySizeOnly evicts the least-recently-used tuple once maxSetSize is reached.
+	EvictionPolicySizeOnly EvictionPolicy = iota
+	// EvictionPolicyTTL evicts tuples that have not been seen again within ttl, regardless of set size.
+	EvictionPolicyTTL
+	// EvictionPolicyLRUTTL combines both: recency bounds the set size, age bounds how long a
+	// stale tuple can linger.
+	EvictionPolicyLRUTTL
+)
+
+// SDKInfoSink receives the accumulated SDKInfo on every flush interval tick that has any, as an
+// alternative to callers polling GetAndResetSDKInfo. Ticks with nothing recorded skip the sink.
+type SDKInfoSink func([]check.SDKInfo)
+
+const (
+	defaultMaxSetSize = 100
+	defaultTTL        = 24 * time.Hour
+)
+
 type SDKVersionInterceptor struct {
-	sdkInfoSet map[sdkNameVersion]struct{}
-	lock       sync.RWMutex
+	lock    sync.Mutex
+	entries map[sdkNameVersion]*list.Element
+	order   *list.List // front = most recently seen, back = least recently seen
+
 	maxSetSize int
+	ttl        time.Duration
+	policy     EvictionPolicy
+
+	flushInterval time.Duration
+	sink          SDKInfoSink
+	stopOnce      sync.Once
+	stopCh        chan struct{}
+}
+
+// SDKVersionInterceptorOption customizes a SDKVersionInterceptor at construction time.
+type SDKVersionInterceptorOption func(*SDKVersionInterceptor)
+
+// WithMaxSetSize overrides the default size bound used by EvictionPolicySizeOnly and
+// EvictionPolicyLRUTTL. A value <= 0 disables size-based eviction.
+func WithMaxSetSize(maxSetSize int) SDKVersionInterceptorOption {
+	return func(vi *SDKVersionInterceptor) {
+		vi.maxSetSize = maxSetSize
+	}
 }
 
-const defaultMaxSetSize = 100
+// WithTTL overrides the default age bound used by EvictionPolicyTTL and EvictionPolicyLRUTTL.
+func WithTTL(ttl time.Duration) SDKVersionInterceptorOption {
+	return func(vi *SDKVersionInterceptor) {
+		vi.ttl = ttl
+	}
+}
+
+// WithEvictionPolicy selects how recorded tuples are evicted. Defaults to EvictionPolicySizeOnly.
+func WithEvictionPolicy(policy EvictionPolicy) SDKVersionInterceptorOption {
+	return func(vi *SDKVersionInterceptor) {
+		vi.policy = policy
+	}
+}
+
+// WithFlushInterval starts a background goroutine that calls sink with the accumulated SDKInfo
+// (draining internal state as GetAndResetSDKInfo would) every interval in which anything was
+// recorded, so callers don't have to poll.
+func WithFlushInterval(interval time.Duration, sink SDKInfoSink) SDKVersionInterceptorOption {
+	return func(vi *SDKVersionInterceptor) {
+		vi.flushInterval = interval
+		vi.sink = sink
+	}
+}
 
 // NewSDKVersionInterceptor creates a new SDKVersionInterceptor with default max set size
-func NewSDKVersionInterceptor() *SDKVersionInterceptor {
-	return &SDKVersionInterceptor{
-		sdkInfoSet: make(map[sdkNameVersion]struct{}),
+func NewSDKVersionInterceptor(opts ...SDKVersionInterceptorOption) *SDKVersionInterceptor {
+	vi := &SDKVersionInterceptor{
+		entries:    make(map[sdkNameVersion]*list.Element),
+		order:      list.New(),
 		maxSetSize: defaultMaxSetSize,
+		ttl:        defaultTTL,
+		policy:     EvictionPolicySizeOnly,
+		stopCh:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(vi)
+	}
+
+	if vi.flushInterval > 0 && vi.sink != nil {
+		go vi.flushLoop()
 	}
+
+	return vi
+}
+
+// Stop terminates the background flush goroutine started by WithFlushInterval, if any.
+func (vi *SDKVersionInterceptor) Stop() {
+	vi.stopOnce.Do(func() { close(vi.stopCh) })
 }
 
 // Intercept a grpc request
@@ -68,32 +160,107 @@ func (vi *SDKVersionInterceptor) Intercept(
 	return handler(ctx, req)
 }
 
-// RecordSDKInfo records name and version tuple in memory
+// RecordSDKInfo records name and version tuple in memory. Recording an already-known tuple
+// refreshes its recency/age so that actively-seen SDKs are never displaced by a long tail of
+// stale ones.
 func (vi *SDKVersionInterceptor) RecordSDKInfo(name, version string) {
-	info := sdkNameVersion{name, version}
+	key := sdkNameVersion{name, version}
+	now := time.Now()
 
-	vi.lock.RLock()
-	overCap := len(vi.sdkInfoSet) >= vi.maxSetSize
-	_, found := vi.sdkInfoSet[info]
-	vi.lock.RUnlock()
+	vi.lock.Lock()
+	defer vi.lock.Unlock()
+
+	if vi.usesTTL() {
+		vi.evictExpiredLocked(now)
+	}
+
+	if el, found := vi.entries[key]; found {
+		el.Value.(*sdkInfoEntry).lastAccess = now
+		vi.order.MoveToFront(el)
+		return
+	}
+
+	if vi.usesSize() && vi.maxSetSize > 0 && len(vi.entries) >= vi.maxSetSize {
+		vi.evictOldestLocked()
+	}
 
-	if !overCap && !found {
-		vi.lock.Lock()
-		vi.sdkInfoSet[info] = struct{}{}
-		vi.lock.Unlock()
+	el := vi.order.PushFront(&sdkInfoEntry{key: key, lastAccess: now})
+	vi.entries[key] = el
+}
+
+func (vi *SDKVersionInterceptor) usesSize() bool {
+	return vi.policy == EvictionPolicySizeOnly || vi.policy == EvictionPolicyLRUTTL
+}
+
+func (vi *SDKVersionInterceptor) usesTTL() bool {
+	return vi.policy == EvictionPolicyTTL || vi.policy == EvictionPolicyLRUTTL
+}
+
+func (vi *SDKVersionInterceptor) evictOldestLocked() {
+	oldest := vi.order.Back()
+	if oldest == nil {
+		return
+	}
+	delete(vi.entries, oldest.Value.(*sdkInfoEntry).key)
+	vi.order.Remove(oldest)
+}
+
+func (vi *SDKVersionInterceptor) evictExpiredLocked(now time.Time) {
+	for {
+		oldest := vi.order.Back()
+		if oldest == nil {
+			return
+		}
+		entry := oldest.Value.(*sdkInfoEntry)
+		if now.Sub(entry.lastAccess) < vi.ttl {
+			return
+		}
+		delete(vi.entries, entry.key)
+		vi.order.Remove(oldest)
 	}
 }
 
 // GetAndResetSDKInfo gets all recorded name, version tuples and resets internal records
 func (vi *SDKVersionInterceptor) GetAndResetSDKInfo() []check.SDKInfo {
 	vi.lock.Lock()
-	currSet := vi.sdkInfoSet
-	vi.sdkInfoSet = make(map[sdkNameVersion]struct{})
-	vi.lock.Unlock()
+	defer vi.lock.Unlock()
+
+	return vi.drainLocked()
+}
 
-	sdkInfo := make([]check.SDKInfo, 0, len(currSet))
-	for k := range currSet {
-		sdkInfo = append(sdkInfo, check.SDKInfo{Name: k.name, Version: k.version})
+func (vi *SDKVersionInterceptor) drainLocked() []check.SDKInfo {
+	if vi.usesTTL() {
+		vi.evictExpiredLocked(time.Now())
 	}
+
+	sdkInfo := make([]check.SDKInfo, 0, len(vi.entries))
+	for el := vi.order.Front(); el != nil; el = el.Next() {
+		entry := el.Value.(*sdkInfoEntry)
+		sdkInfo = append(sdkInfo, check.SDKInfo{Name: entry.key.name, Version: entry.key.version})
+	}
+
+	vi.entries = make(map[sdkNameVersion]*list.Element)
+	vi.order = list.New()
+
 	return sdkInfo
 }
+
+func (vi *SDKVersionInterceptor) flushLoop() {
+	ticker := time.NewTicker(vi.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			vi.lock.Lock()
+			sdkInfo := vi.drainLocked()
+			vi.lock.Unlock()
+
+			if len(sdkInfo) > 0 {
+				vi.sink(sdkInfo)
+			}
+		case <-vi.stopCh:
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2022 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/version/check"
+)
+
+func TestSDKVersionInterceptor_SizeOnlyEvictsLeastRecentlyUsed(t *testing.T) {
+	vi := NewSDKVersionInterceptor(WithMaxSetSize(2), WithEvictionPolicy(EvictionPolicySizeOnly))
+
+	vi.RecordSDKInfo("go", "1.0.0")
+	vi.RecordSDKInfo("java", "1.0.0")
+	vi.RecordSDKInfo("go", "1.0.0") // re-seen: moves "go" back to most-recently-used
+	vi.RecordSDKInfo("python", "1.0.0")
+
+	info := vi.GetAndResetSDKInfo()
+	require.Len(t, info, 2)
+	require.Contains(t, info, check.SDKInfo{Name: "go", Version: "1.0.0"})
+	require.Contains(t, info, check.SDKInfo{Name: "python", Version: "1.0.0"})
+}
+
+func TestSDKVersionInterceptor_TTLEvictsStaleEntries(t *testing.T) {
+	vi := NewSDKVersionInterceptor(WithTTL(10*time.Millisecond), WithEvictionPolicy(EvictionPolicyTTL))
+
+	vi.RecordSDKInfo("go", "1.0.0")
+	time.Sleep(15 * time.Millisecond)
+	vi.RecordSDKInfo("java", "1.0.0")
+
+	info := vi.GetAndResetSDKInfo()
+	require.Len(t, info, 1)
+	require.Equal(t, check.SDKInfo{Name: "java", Version: "1.0.0"}, info[0])
+}
+
+func TestSDKVersionInterceptor_LRUTTLCombinesBothBounds(t *testing.T) {
+	vi := NewSDKVersionInterceptor(
+		WithMaxSetSize(5),
+		WithTTL(10*time.Millisecond),
+		WithEvictionPolicy(EvictionPolicyLRUTTL),
+	)
+
+	vi.RecordSDKInfo("stale", "1.0.0")
+	time.Sleep(15 * time.Millisecond)
+	vi.RecordSDKInfo("fresh", "1.0.0")
+
+	info := vi.GetAndResetSDKInfo()
+	require.Len(t, info, 1)
+	require.Equal(t, check.SDKInfo{Name: "fresh", Version: "1.0.0"}, info[0])
+}
+
+func TestSDKVersionInterceptor_GetAndResetSDKInfoDrainsState(t *testing.T) {
+	vi := NewSDKVersionInterceptor()
+
+	vi.RecordSDKInfo("go", "1.0.0")
+	require.Len(t, vi.GetAndResetSDKInfo(), 1)
+	require.Empty(t, vi.GetAndResetSDKInfo())
+}
+
+func TestSDKVersionInterceptor_FlushLoopSinksOnInterval(t *testing.T) {
+	flushed := make(chan []check.SDKInfo, 1)
+	vi := NewSDKVersionInterceptor(WithFlushInterval(10*time.Millisecond, func(info []check.SDKInfo) {
+		flushed <- info
+	}))
+	defer vi.Stop()
+
+	vi.RecordSDKInfo("go", "1.0.0")
+
+	select {
+	case info := <-flushed:
+		require.Equal(t, []check.SDKInfo{{Name: "go", Version: "1.0.0"}}, info)
+	case <-time.After(time.Second):
+		t.Fatal("flush loop did not sink accumulated SDK info in time")
+	}
+
+	require.Empty(t, vi.GetAndResetSDKInfo(), "flush should have drained internal state")
+}
+
+func TestSDKVersionInterceptor_StopTerminatesFlushLoop(t *testing.T) {
+	flushed := make(chan []check.SDKInfo, 1)
+	vi := NewSDKVersionInterceptor(WithFlushInterval(5*time.Millisecond, func(info []check.SDKInfo) {
+		flushed <- info
+	}))
+
+	vi.Stop()
+	require.NotPanics(t, func() { vi.Stop() }, "Stop must be idempotent")
+	time.Sleep(20 * time.Millisecond)
+
+	vi.RecordSDKInfo("go", "1.0.0")
+	select {
+	case <-flushed:
+		t.Fatal("flush loop kept sinking after Stop")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Len(t, vi.GetAndResetSDKInfo(), 1, "a stopped flush loop must not drain internal state")
+}
+
+func TestSDKVersionInterceptor_ManyUniqueEntriesRespectMaxSetSize(t *testing.T) {
+	const maxSetSize = 10
+	vi := NewSDKVersionInterceptor(WithMaxSetSize(maxSetSize))
+
+	for i := 0; i < maxSetSize*3; i++ {
+		vi.RecordSDKInfo(fmt.Sprintf("sdk-%d", i), "1.0.0")
+	}
+
+	require.Len(t, vi.GetAndResetSDKInfo(), maxSetSize)
+}
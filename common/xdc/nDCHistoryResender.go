@@ -24,25 +24,52 @@ package xdc
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/temporal-proto/common"
 	executionpb "go.temporal.io/temporal-proto/execution"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
 	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/client/admin"
+	"github.com/temporalio/temporal/common/backoff"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/collection"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/metrics"
 	"github.com/temporalio/temporal/common/persistence"
 	"github.com/temporalio/temporal/common/rpc"
 )
 
 const (
 	resendContextTimeout = 30 * time.Second
+
+	defaultCircuitBreakerMaxFailures   = 5
+	defaultCircuitBreakerResetInterval = 30 * time.Second
+)
+
+// tracer emits spans for the admin/history RPCs issued while resending history, so a slow
+// cross-DC resend can be correlated back to the specific RPC that stalled.
+var tracer = otel.Tracer("github.com/temporalio/temporal/common/xdc")
+
+const (
+	// ErrorPolicyBestEffort lets every run complete or fail independently and aggregates all
+	// errors. This is the zero value/default: a bulk resync of thousands of workflows (the case
+	// this API was built for) should not be aborted by one already-deleted or otherwise
+	// non-retryable run.
+	ErrorPolicyBestEffort ErrorPolicy = iota
+	// ErrorPolicyFailFast cancels all in-flight and not-yet-started runs as soon as one run fails.
+	ErrorPolicyFailFast
 )
 
 type (
@@ -62,6 +89,39 @@ type (
 			endEventID int64,
 			endEventVersion int64,
 		) error
+		// SendMultipleWorkflowHistory sends history events for multiple runs to remote concurrently
+		SendMultipleWorkflowHistory(
+			ctx context.Context,
+			specs []ResendSpec,
+			opts ResendOptions,
+		) error
+	}
+
+	// ResendSpec identifies a single (namespaceID, workflowID, runID) history range to resend
+	ResendSpec struct {
+		NamespaceID       string
+		WorkflowID        string
+		RunID             string
+		StartEventID      int64
+		StartEventVersion int64
+		EndEventID        int64
+		EndEventVersion   int64
+	}
+
+	// ErrorPolicy controls how SendMultipleWorkflowHistory reacts to a per-run failure
+	ErrorPolicy int
+
+	// ResendOptions configures a SendMultipleWorkflowHistory call
+	ResendOptions struct {
+		// Concurrency bounds how many runs are resent in parallel. Defaults to 1 if <= 0.
+		Concurrency int
+		// MaxInFlightPages bounds how many fetched-but-not-yet-replicated history pages are
+		// buffered per run, allowing fetch and replicate to overlap. Defaults to 1 if <= 0.
+		MaxInFlightPages int
+		// ErrorPolicy controls whether one run's failure aborts the rest. Defaults to
+		// ErrorPolicyBestEffort (the zero value); set ErrorPolicyFailFast explicitly to abort
+		// the remaining runs on the first failure.
+		ErrorPolicy ErrorPolicy
 	}
 
 	// NDCHistoryResenderImpl is the implementation of NDCHistoryResender
@@ -71,14 +131,61 @@ type (
 		historyReplicationFn nDCHistoryReplicationFn
 		serializer           persistence.PayloadSerializer
 		logger               log.Logger
+		metricsClient        metrics.Client
+		remoteClusterName    string
+		retryPolicy          backoff.RetryPolicy
+		// adminCircuitBreaker and replicationCircuitBreaker track two independent failure
+		// domains keyed off the same remote cluster: the remote admin client RPC
+		// (GetWorkflowExecutionRawHistoryV2) and the local historyReplicationFn call,
+		// respectively. A blip in one must not fast-fail the other.
+		adminCircuitBreaker       *circuitBreaker
+		replicationCircuitBreaker *circuitBreaker
 	}
 
 	historyBatch struct {
 		versionHistory *eventgenpb.VersionHistory
 		rawEventBatch  *commonpb.DataBlob
 	}
+
+	// NDCHistoryResenderOption customizes an NDCHistoryResenderImpl at construction time
+	NDCHistoryResenderOption func(*NDCHistoryResenderImpl)
 )
 
+// WithRemoteClusterName tags the resender's logs and circuit breaker with the name of the
+// remote cluster fronted by the adminClient it was constructed with.
+func WithRemoteClusterName(remoteClusterName string) NDCHistoryResenderOption {
+	return func(n *NDCHistoryResenderImpl) {
+		n.remoteClusterName = remoteClusterName
+	}
+}
+
+// WithRetryPolicy overrides the default exponential backoff retry policy used around
+// GetWorkflowExecutionRawHistoryV2 and the history replication call.
+func WithRetryPolicy(policy backoff.RetryPolicy) NDCHistoryResenderOption {
+	return func(n *NDCHistoryResenderImpl) {
+		n.retryPolicy = policy
+	}
+}
+
+// WithCircuitBreakerSettings overrides the default failure threshold and open-state reset
+// interval of the circuit breaker guarding the remote admin client RPC
+// (GetWorkflowExecutionRawHistoryV2), keyed off the admin client's target cluster.
+func WithCircuitBreakerSettings(maxFailures int, resetInterval time.Duration) NDCHistoryResenderOption {
+	return func(n *NDCHistoryResenderImpl) {
+		n.adminCircuitBreaker = newCircuitBreaker(maxFailures, resetInterval)
+	}
+}
+
+// WithReplicationCircuitBreakerSettings overrides the default failure threshold and open-state
+// reset interval of the circuit breaker guarding the local historyReplicationFn call. This is
+// intentionally independent from WithCircuitBreakerSettings: a stuck local history service
+// should not fast-fail fetches from an otherwise healthy remote cluster, and vice versa.
+func WithReplicationCircuitBreakerSettings(maxFailures int, resetInterval time.Duration) NDCHistoryResenderOption {
+	return func(n *NDCHistoryResenderImpl) {
+		n.replicationCircuitBreaker = newCircuitBreaker(maxFailures, resetInterval)
+	}
+}
+
 // NewNDCHistoryResender create a new NDCHistoryResenderImpl
 func NewNDCHistoryResender(
 	namespaceCache cache.NamespaceCache,
@@ -86,15 +193,78 @@ func NewNDCHistoryResender(
 	historyReplicationFn nDCHistoryReplicationFn,
 	serializer persistence.PayloadSerializer,
 	logger log.Logger,
+	metricsClient metrics.Client,
+	opts ...NDCHistoryResenderOption,
 ) *NDCHistoryResenderImpl {
 
-	return &NDCHistoryResenderImpl{
-		namespaceCache:       namespaceCache,
-		adminClient:          adminClient,
-		historyReplicationFn: historyReplicationFn,
-		serializer:           serializer,
-		logger:               logger,
+	n := &NDCHistoryResenderImpl{
+		namespaceCache:            namespaceCache,
+		adminClient:               adminClient,
+		historyReplicationFn:      historyReplicationFn,
+		serializer:                serializer,
+		logger:                    logger,
+		metricsClient:             metricsClient,
+		retryPolicy:               defaultRetryPolicy(),
+		adminCircuitBreaker:       newCircuitBreaker(defaultCircuitBreakerMaxFailures, defaultCircuitBreakerResetInterval),
+		replicationCircuitBreaker: newCircuitBreaker(defaultCircuitBreakerMaxFailures, defaultCircuitBreakerResetInterval),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+func defaultRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(200 * time.Millisecond)
+	policy.SetMaximumInterval(10 * time.Second)
+	// Must leave room for several per-attempt timeouts, not just one: a single attempt that
+	// times out (DeadlineExceeded, the transient error this policy exists to retry) already
+	// consumes resendContextTimeout, so an expiration equal to it would let backoff.Retry give
+	// up after that first attempt regardless of SetMaximumAttempts.
+	policy.SetExpirationInterval(5 * resendContextTimeout)
+	policy.SetMaximumAttempts(5)
+	return policy
+}
+
+// metricsScope returns a metrics.Scope pre-tagged with the namespace and remote cluster being
+// resent to, so every NDCHistoryResender metric can be sliced by source/target cluster and namespace.
+func (n *NDCHistoryResenderImpl) metricsScope(namespaceID string) metrics.Scope {
+	return n.metricsClient.Scope(
+		metrics.NDCHistoryResenderScope,
+		metrics.NamespaceIDTag(namespaceID),
+		metrics.TargetClusterTag(n.remoteClusterName),
+	)
+}
+
+// isRetryableError classifies an admin/history RPC error as retryable (transient remote cluster
+// or network trouble) or terminal (request is simply wrong and retrying cannot help).
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordRPCOutcome updates cb based on the outcome of a retried RPC. Only a transient error as
+// classified by isRetryableError counts as a breaker failure: a terminal, request-specific error
+// (e.g. NotFound for an already-purged workflow) or a plain ctx cancellation is not the remote's
+// fault and must not trip the breaker for every other healthy call sharing it. Such an error on
+// a half-open probe only frees the probe slot, so a later call can probe again.
+func recordRPCOutcome(cb *circuitBreaker, err error) {
+	if err == nil {
+		cb.RecordSuccess()
+		return
+	}
+	if isRetryableError(err) {
+		cb.RecordFailure()
+		return
 	}
+	cb.ReleaseProbe()
 }
 
 // SendSingleWorkflowHistory sends one run IDs's history events to remote
@@ -108,48 +278,342 @@ func (n *NDCHistoryResenderImpl) SendSingleWorkflowHistory(
 	endEventVersion int64,
 ) error {
 
+	return n.sendSingleWorkflowHistory(
+		context.Background(),
+		ResendSpec{
+			NamespaceID:       namespaceID,
+			WorkflowID:        workflowID,
+			RunID:             runID,
+			StartEventID:      startEventID,
+			StartEventVersion: startEventVersion,
+			EndEventID:        endEventID,
+			EndEventVersion:   endEventVersion,
+		},
+		1,
+	)
+}
+
+// SendMultipleWorkflowHistory sends history events for multiple runs to remote, fanning out
+// across a bounded worker pool so that slow or stuck runs do not serialize the whole batch.
+func (n *NDCHistoryResenderImpl) SendMultipleWorkflowHistory(
+	ctx context.Context,
+	specs []ResendSpec,
+	opts ResendOptions,
+) error {
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, concurrency)
+	errs := make([]error, len(specs))
+	var wg sync.WaitGroup
+
+specLoop:
+	for i, spec := range specs {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue specLoop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, spec ResendSpec) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := n.sendSingleWorkflowHistory(ctx, spec, opts.MaxInFlightPages); err != nil {
+				errs[i] = err
+				if opts.ErrorPolicy == ErrorPolicyFailFast {
+					cancel()
+				}
+			}
+		}(i, spec)
+	}
+	wg.Wait()
+
+	return aggregateResendErrors(errs)
+}
+
+// sendSingleWorkflowHistory resends one run's history, overlapping page fetches with
+// replication: a fetcher goroutine streams historyBatch items through a channel while
+// this goroutine replicates them, so the next page's RPC does not wait on the current
+// page's replicate call.
+func (n *NDCHistoryResenderImpl) sendSingleWorkflowHistory(
+	ctx context.Context,
+	spec ResendSpec,
+	maxInFlightPages int,
+) (retErr error) {
+
+	scope := n.metricsScope(spec.NamespaceID)
+	sw := scope.StartTimer(metrics.NDCHistoryResenderTotalLatency)
+	defer sw.Stop()
+
+	// Bound the fetcher goroutine below to this call's lifetime: without this, a caller using
+	// an uncancelable ctx (e.g. SendSingleWorkflowHistory's context.Background()) would leak the
+	// fetcher forever if we return early on a replicate error while pages remain unread, since
+	// the fetcher's send on batchCh would then have no reader and no ctx.Done() to fall back on.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctx, span := tracer.Start(ctx, "NDCHistoryResender.SendSingleWorkflowHistory",
+		trace.WithAttributes(
+			attribute.String("temporal.namespace_id", spec.NamespaceID),
+			attribute.String("temporal.workflow_id", spec.WorkflowID),
+			attribute.String("temporal.run_id", spec.RunID),
+			attribute.String("temporal.target_cluster", n.remoteClusterName),
+		))
+	defer func() {
+		if retErr != nil {
+			span.RecordError(retErr)
+			span.SetStatus(otelcodes.Error, retErr.Error())
+		}
+		span.End()
+	}()
+
+	if maxInFlightPages <= 0 {
+		maxInFlightPages = 1
+	}
+
 	historyIterator := collection.NewPagingIterator(n.getPaginationFn(
-		namespaceID,
-		workflowID,
-		runID,
-		startEventID,
-		startEventVersion,
-		endEventID,
-		endEventVersion))
-
-	for historyIterator.HasNext() {
-		result, err := historyIterator.Next()
-		if err != nil {
-			n.logger.Error("failed to get history events",
-				tag.WorkflowNamespaceID(namespaceID),
-				tag.WorkflowID(workflowID),
-				tag.WorkflowRunID(runID),
+		ctx,
+		spec.NamespaceID,
+		spec.WorkflowID,
+		spec.RunID,
+		spec.StartEventID,
+		spec.StartEventVersion,
+		spec.EndEventID,
+		spec.EndEventVersion))
+
+	return drainAndReplicate(ctx, n.logger, spec, historyIterator, maxInFlightPages, func(batch *historyBatch) error {
+		replicationRequest := n.createReplicationRawRequest(
+			spec.NamespaceID,
+			spec.WorkflowID,
+			spec.RunID,
+			batch.rawEventBatch,
+			batch.versionHistory.GetItems())
+		return n.sendReplicationRawRequest(ctx, replicationRequest)
+	})
+}
+
+// drainAndReplicate overlaps page fetches with replication: a fetcher goroutine streams
+// historyBatch items from historyIterator through a channel while this goroutine replicates
+// them via replicate, so the next page's RPC does not wait on the current page's replicate
+// call. The fetcher exits as soon as ctx is done, even if replicate returned early with pages
+// still unread, which is what bounds its lifetime to the caller's.
+//
+// This is a free function, independent of NDCHistoryResenderImpl, so the fetch/replicate
+// overlap and its ctx-bound fetcher lifetime can be unit tested without an adminClient,
+// namespaceCache, or metricsClient.
+func drainAndReplicate(
+	ctx context.Context,
+	logger log.Logger,
+	spec ResendSpec,
+	historyIterator collection.Iterator,
+	maxInFlightPages int,
+	replicate func(batch *historyBatch) error,
+) error {
+	batchCh := make(chan *historyBatch, maxInFlightPages)
+	fetchErrCh := make(chan error, 1)
+
+	go func() {
+		defer close(batchCh)
+		for historyIterator.HasNext() {
+			select {
+			case <-ctx.Done():
+				fetchErrCh <- ctx.Err()
+				return
+			default:
+			}
+
+			result, err := historyIterator.Next()
+			if err != nil {
+				logger.Error("failed to get history events",
+					tag.WorkflowNamespaceID(spec.NamespaceID),
+					tag.WorkflowID(spec.WorkflowID),
+					tag.WorkflowRunID(spec.RunID),
+					tag.Error(err))
+				fetchErrCh <- err
+				return
+			}
+
+			select {
+			case batchCh <- result.(*historyBatch):
+			case <-ctx.Done():
+				fetchErrCh <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	for batch := range batchCh {
+		if err := replicate(batch); err != nil {
+			logger.Error("failed to replicate events",
+				tag.WorkflowNamespaceID(spec.NamespaceID),
+				tag.WorkflowID(spec.WorkflowID),
+				tag.WorkflowRunID(spec.RunID),
 				tag.Error(err))
 			return err
 		}
-		historyBatch := result.(*historyBatch)
+	}
 
-		replicationRequest := n.createReplicationRawRequest(
-			namespaceID,
-			workflowID,
-			runID,
-			historyBatch.rawEventBatch,
-			historyBatch.versionHistory.GetItems())
+	select {
+	case err := <-fetchErrCh:
+		return err
+	default:
+		return nil
+	}
+}
 
-		err = n.sendReplicationRawRequest(replicationRequest)
+// aggregateResendErrors collapses the per-run error slice produced by SendMultipleWorkflowHistory
+// into a single error, preserving every distinct failure for the caller/logs.
+func aggregateResendErrors(errs []error) error {
+	var failed []error
+	for _, err := range errs {
 		if err != nil {
-			n.logger.Error("failed to replicate events",
-				tag.WorkflowNamespaceID(namespaceID),
-				tag.WorkflowID(workflowID),
-				tag.WorkflowRunID(runID),
-				tag.Error(err))
-			return err
+			failed = append(failed, err)
 		}
 	}
-	return nil
+	if len(failed) == 0 {
+		return nil
+	}
+	if len(failed) == 1 {
+		return failed[0]
+	}
+	return &multiError{errs: failed}
+}
+
+// multiError aggregates multiple run failures from a best-effort SendMultipleWorkflowHistory call.
+type multiError struct {
+	errs []error
+}
+
+func (e *multiError) Error() string {
+	msgs := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// circuitBreakerOpenError is returned instead of issuing an RPC once a remote cluster has
+// tripped its circuit breaker, so callers fail fast instead of tying up a goroutine in a timeout.
+type circuitBreakerOpenError struct {
+	remoteClusterName string
+}
+
+func (e *circuitBreakerOpenError) Error() string {
+	return "circuit breaker open for remote cluster " + e.remoteClusterName
+}
+
+type circuitBreakerState int
+
+const (
+	circuitClosed circuitBreakerState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker is a minimal per-remote-cluster breaker: it opens after maxFailures
+// consecutive errors and stays open for resetInterval before allowing a single half-open
+// probe through. It intentionally does not distinguish error types itself - recordRPCOutcome
+// is what keeps terminal, request-specific errors from counting as breaker failures.
+type circuitBreaker struct {
+	mu               sync.Mutex
+	state            circuitBreakerState
+	maxFailures      int
+	resetInterval    time.Duration
+	failureCount     int
+	openedAt         time.Time
+	halfOpenInFlight bool
+}
+
+func newCircuitBreaker(maxFailures int, resetInterval time.Duration) *circuitBreaker {
+	if maxFailures <= 0 {
+		maxFailures = defaultCircuitBreakerMaxFailures
+	}
+	if resetInterval <= 0 {
+		resetInterval = defaultCircuitBreakerResetInterval
+	}
+	return &circuitBreaker{
+		maxFailures:   maxFailures,
+		resetInterval: resetInterval,
+	}
+}
+
+// Allow reports whether a call may proceed, claiming the single half-open probe slot if the
+// breaker's reset interval has elapsed.
+func (cb *circuitBreaker) Allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitClosed:
+		return true
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.resetInterval {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		cb.halfOpenInFlight = true
+		return true
+	case circuitHalfOpen:
+		return !cb.halfOpenInFlight
+	default:
+		return true
+	}
+}
+
+func (cb *circuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.state = circuitClosed
+	cb.failureCount = 0
+	cb.halfOpenInFlight = false
+}
+
+func (cb *circuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.halfOpenInFlight = false
+	if cb.state == circuitHalfOpen {
+		cb.trip()
+		return
+	}
+
+	cb.failureCount++
+	if cb.failureCount >= cb.maxFailures {
+		cb.trip()
+	}
+}
+
+// ReleaseProbe frees a claimed half-open probe slot without judging the remote's health, so the
+// next Allow grants a fresh probe. It has no effect unless the breaker is half-open.
+func (cb *circuitBreaker) ReleaseProbe() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+		cb.halfOpenInFlight = false
+	}
+}
+
+func (cb *circuitBreaker) trip() {
+	cb.state = circuitOpen
+	cb.failureCount = 0
+	cb.openedAt = time.Now()
 }
 
 func (n *NDCHistoryResenderImpl) getPaginationFn(
+	ctx context.Context,
 	namespaceID string,
 	workflowID string,
 	runID string,
@@ -162,6 +626,7 @@ func (n *NDCHistoryResenderImpl) getPaginationFn(
 	return func(paginationToken []byte) ([]interface{}, []byte, error) {
 
 		response, err := n.getHistory(
+			ctx,
 			namespaceID,
 			workflowID,
 			runID,
@@ -210,15 +675,51 @@ func (n *NDCHistoryResenderImpl) createReplicationRawRequest(
 }
 
 func (n *NDCHistoryResenderImpl) sendReplicationRawRequest(
+	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
 ) error {
 
-	ctx, cancel := context.WithTimeout(context.Background(), resendContextTimeout)
-	defer cancel()
-	return n.historyReplicationFn(ctx, request)
+	scope := n.metricsScope(request.GetNamespaceId())
+
+	ctx, span := tracer.Start(ctx, "NDCHistoryResender.historyReplicationFn",
+		trace.WithAttributes(
+			attribute.String("temporal.namespace_id", request.GetNamespaceId()),
+			attribute.String("temporal.workflow_id", request.GetWorkflowExecution().GetWorkflowId()),
+			attribute.String("temporal.run_id", request.GetWorkflowExecution().GetRunId()),
+		))
+	defer span.End()
+
+	if !n.replicationCircuitBreaker.Allow() {
+		n.logger.Error("replication circuit breaker open, failing replication call fast",
+			tag.TargetCluster(n.remoteClusterName))
+		err := &circuitBreakerOpenError{remoteClusterName: n.remoteClusterName}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return err
+	}
+
+	sw := scope.StartTimer(metrics.NDCHistoryResenderReplicateLatency)
+	op := func() error {
+		ctx, cancel := context.WithTimeout(ctx, resendContextTimeout)
+		defer cancel()
+		return n.historyReplicationFn(ctx, request)
+	}
+
+	err := backoff.Retry(op, n.retryPolicy, isRetryableError)
+	sw.Stop()
+	recordRPCOutcome(n.replicationCircuitBreaker, err)
+	if err != nil {
+		scope.Tagged(metrics.ServiceErrorTypeTag(status.Code(err).String())).IncCounter(metrics.NDCHistoryResenderErrorCount)
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return err
+	}
+	scope.IncCounter(metrics.NDCHistoryResenderBatchReplicatedCount)
+	return nil
 }
 
 func (n *NDCHistoryResenderImpl) getHistory(
+	ctx context.Context,
 	namespaceID string,
 	workflowID string,
 	runID string,
@@ -230,34 +731,92 @@ func (n *NDCHistoryResenderImpl) getHistory(
 	pageSize int32,
 ) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 
-	logger := n.logger.WithTags(tag.WorkflowRunID(runID))
+	logger := n.logger.WithTags(tag.WorkflowRunID(runID), tag.TargetCluster(n.remoteClusterName))
+	scope := n.metricsScope(namespaceID)
+
+	ctx, span := tracer.Start(ctx, "NDCHistoryResender.GetWorkflowExecutionRawHistoryV2",
+		trace.WithAttributes(
+			attribute.String("temporal.namespace_id", namespaceID),
+			attribute.String("temporal.workflow_id", workflowID),
+			attribute.String("temporal.run_id", runID),
+		))
+	defer span.End()
 
 	namespaceEntry, err := n.namespaceCache.GetNamespaceByID(namespaceID)
 	if err != nil {
 		logger.Error("error getting namespace", tag.Error(err))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
 		return nil, err
 	}
 	namespace := namespaceEntry.GetInfo().Name
 
-	ctx, cancel := rpc.NewContextWithTimeoutAndHeaders(resendContextTimeout)
-	defer cancel()
-	response, err := n.adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
-		Namespace: namespace,
-		Execution: &executionpb.WorkflowExecution{
-			WorkflowId: workflowID,
-			RunId:      runID,
-		},
-		StartEventId:      startEventID,
-		StartEventVersion: startEventVersion,
-		EndEventId:        endEventID,
-		EndEventVersion:   endEventVersion,
-		MaximumPageSize:   pageSize,
-		NextPageToken:     token,
-	})
+	if !n.adminCircuitBreaker.Allow() {
+		logger.Error("admin circuit breaker open, failing history fetch fast")
+		err := &circuitBreakerOpenError{remoteClusterName: n.remoteClusterName}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, err
+	}
+
+	var response *adminservice.GetWorkflowExecutionRawHistoryV2Response
+	sw := scope.StartTimer(metrics.NDCHistoryResenderGetHistoryLatency)
+	op := func() error {
+		rpcCtx, cancel := rpc.NewContextWithTimeoutAndHeaders(resendContextTimeout)
+		defer cancel()
+
+		// rpc.NewContextWithTimeoutAndHeaders is not derived from ctx (it only takes a
+		// timeout), so without this select an outer cancellation - e.g. a sibling run's
+		// ErrorPolicyFailFast in SendMultipleWorkflowHistory - would be invisible here and
+		// this attempt would run its full timeout regardless.
+		type rpcResult struct {
+			response *adminservice.GetWorkflowExecutionRawHistoryV2Response
+			err      error
+		}
+		resultCh := make(chan rpcResult, 1)
+		go func() {
+			resp, err := n.adminClient.GetWorkflowExecutionRawHistoryV2(rpcCtx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+				Namespace: namespace,
+				Execution: &executionpb.WorkflowExecution{
+					WorkflowId: workflowID,
+					RunId:      runID,
+				},
+				StartEventId:      startEventID,
+				StartEventVersion: startEventVersion,
+				EndEventId:        endEventID,
+				EndEventVersion:   endEventVersion,
+				MaximumPageSize:   pageSize,
+				NextPageToken:     token,
+			})
+			resultCh <- rpcResult{response: resp, err: err}
+		}()
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			return ctx.Err()
+		case r := <-resultCh:
+			response = r.response
+			return r.err
+		}
+	}
+
+	err = backoff.Retry(op, n.retryPolicy, isRetryableError)
+	sw.Stop()
+	recordRPCOutcome(n.adminCircuitBreaker, err)
 	if err != nil {
 		logger.Error("error getting history", tag.Error(err))
+		scope.Tagged(metrics.ServiceErrorTypeTag(status.Code(err).String())).IncCounter(metrics.NDCHistoryResenderErrorCount)
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
 		return nil, err
 	}
 
+	batches := response.GetHistoryBatches()
+	scope.AddCounter(metrics.NDCHistoryResenderBatchFetchedCount, int64(len(batches)))
+	for _, batch := range batches {
+		scope.AddCounter(metrics.NDCHistoryResenderBytesCount, int64(len(batch.GetData())))
+	}
+
 	return response, nil
 }
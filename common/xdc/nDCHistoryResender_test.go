@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/temporalio/temporal/common/collection"
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+)
+
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...tag.Tag)         {}
+func (noopLogger) Info(string, ...tag.Tag)          {}
+func (noopLogger) Warn(string, ...tag.Tag)          {}
+func (noopLogger) Error(string, ...tag.Tag)         {}
+func (noopLogger) Fatal(string, ...tag.Tag)         {}
+func (l noopLogger) WithTags(...tag.Tag) log.Logger { return l }
+
+// newFakeHistoryIterator returns an iterator yielding n distinct, instantly-available
+// historyBatch pages, one per call to Next, mirroring what getPaginationFn produces without
+// needing an adminClient or namespaceCache.
+func newFakeHistoryIterator(n int) collection.Iterator {
+	produced := 0
+	fn := collection.PaginationFn(func(_ []byte) ([]interface{}, []byte, error) {
+		if produced >= n {
+			return nil, nil, nil
+		}
+		produced++
+		items := []interface{}{&historyBatch{}}
+		var nextToken []byte
+		if produced < n {
+			nextToken = []byte("more")
+		}
+		return items, nextToken, nil
+	})
+	return collection.NewPagingIterator(fn)
+}
+
+// TestDrainAndReplicate_FetcherExitsOnReplicateError reproduces the scenario a mid-stream
+// replicate failure with more pages than maxInFlightPages still unread: the fetcher must stop
+// once ctx is canceled, rather than blocking forever on a batchCh nobody is draining.
+func TestDrainAndReplicate_FetcherExitsOnReplicateError(t *testing.T) {
+	const pageCount = 5
+	const maxInFlightPages = 2
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	before := runtime.NumGoroutine()
+
+	iterator := newFakeHistoryIterator(pageCount)
+	replicateErr := errors.New("replicate failed")
+	var replicated int
+	replicate := func(batch *historyBatch) error {
+		replicated++
+		return replicateErr
+	}
+
+	err := drainAndReplicate(ctx, noopLogger{}, ResendSpec{}, iterator, maxInFlightPages, replicate)
+	require.ErrorIs(t, err, replicateErr)
+	require.Equal(t, 1, replicated)
+
+	// Mirrors sendSingleWorkflowHistory's deferred cancel once the caller has returned.
+	cancel()
+
+	require.Eventually(t, func() bool {
+		return runtime.NumGoroutine() <= before
+	}, time.Second, 10*time.Millisecond, "fetcher goroutine leaked after replicate failure")
+}
+
+// TestDrainAndReplicate_ReplicatesAllPages covers the ordinary overlapped-fetch/replicate path
+// with no failures: every page produced by the iterator reaches replicate exactly once.
+func TestDrainAndReplicate_ReplicatesAllPages(t *testing.T) {
+	const pageCount = 4
+
+	iterator := newFakeHistoryIterator(pageCount)
+	var replicated int
+	replicate := func(batch *historyBatch) error {
+		replicated++
+		return nil
+	}
+
+	err := drainAndReplicate(context.Background(), noopLogger{}, ResendSpec{}, iterator, 2, replicate)
+	require.NoError(t, err)
+	require.Equal(t, pageCount, replicated)
+}
+
+func TestAggregateResendErrors(t *testing.T) {
+	require.NoError(t, aggregateResendErrors([]error{nil, nil}))
+
+	single := errors.New("boom")
+	require.Equal(t, single, aggregateResendErrors([]error{nil, single}))
+
+	err := aggregateResendErrors([]error{errors.New("a"), nil, errors.New("b")})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a")
+	require.Contains(t, err.Error(), "b")
+}
+
+func TestRecordRPCOutcome_OnlyTransientErrorsTripTheBreaker(t *testing.T) {
+	cb := newCircuitBreaker(1, time.Minute)
+
+	// A terminal, request-specific error (e.g. NotFound for an already-purged workflow) must
+	// not trip the breaker, or resending a handful of stale runs would fast-fail every other
+	// healthy resend to the cluster.
+	recordRPCOutcome(cb, status.Error(codes.NotFound, "workflow not found"))
+	require.True(t, cb.Allow())
+
+	// A plain ctx cancellation - e.g. a sibling run's ErrorPolicyFailFast - must not trip the
+	// breaker either, since it says nothing about the remote cluster's health.
+	recordRPCOutcome(cb, context.Canceled)
+	require.True(t, cb.Allow())
+
+	// A transient, retryable error is what the breaker exists to catch.
+	recordRPCOutcome(cb, status.Error(codes.Unavailable, "remote cluster unreachable"))
+	require.False(t, cb.Allow())
+}
+
+func TestCircuitBreaker_TripsAfterMaxFailuresAndHalfOpenRecovers(t *testing.T) {
+	cb := newCircuitBreaker(3, 20*time.Millisecond)
+
+	require.True(t, cb.Allow())
+	cb.RecordFailure()
+	require.True(t, cb.Allow())
+	cb.RecordFailure()
+	require.True(t, cb.Allow())
+	cb.RecordFailure() // 3rd consecutive failure trips the breaker
+
+	require.False(t, cb.Allow())
+
+	time.Sleep(25 * time.Millisecond)
+	require.True(t, cb.Allow(), "breaker should allow a single half-open probe after resetInterval")
+	require.False(t, cb.Allow(), "only one half-open probe should be in flight at a time")
+
+	cb.RecordSuccess()
+	require.True(t, cb.Allow())
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	cb := newCircuitBreaker(1, 10*time.Millisecond)
+
+	cb.RecordFailure()
+	require.False(t, cb.Allow())
+
+	time.Sleep(15 * time.Millisecond)
+	require.True(t, cb.Allow()) // half-open probe granted
+
+	cb.RecordFailure() // probe failed, breaker must reopen
+	require.False(t, cb.Allow())
+}